@@ -0,0 +1,70 @@
+// Stand-in for the obsidian package, which only exists inside the app.
+// vitest.config.ts aliases 'obsidian' here so imports resolve under test;
+// test files still vi.mock('obsidian') with the behaviour they need.
+
+export class App {}
+
+export class Component {
+	load(): void {}
+	unload(): void {}
+}
+
+export class Events {
+	on(): void {}
+	off(): void {}
+	trigger(): void {}
+}
+
+export class Editor {}
+
+export class MarkdownView {}
+
+export class TFile {
+	path = '';
+}
+
+export class Notice {
+	constructor(public message: string, public timeout?: number) {}
+	setMessage(message: string): this {
+		this.message = message;
+		return this;
+	}
+	hide(): void {}
+}
+
+export class Menu {
+	addItem(): this {
+		return this;
+	}
+	addSeparator(): this {
+		return this;
+	}
+	showAtMouseEvent(): void {}
+}
+
+export class Modal {
+	constructor(public app: App) {}
+	open(): void {}
+	close(): void {}
+}
+
+export class Plugin {
+	constructor(public app: App, public manifest: unknown) {}
+}
+
+export class PluginSettingTab {
+	constructor(public app: App, public plugin: Plugin) {}
+}
+
+export class Setting {
+	constructor(public containerEl: unknown) {}
+}
+
+export class ButtonComponent {}
+export class DropdownComponent {}
+export class SliderComponent {}
+export class TextAreaComponent {}
+export class TextComponent {}
+export class ToggleComponent {}
+
+export function setTooltip(): void {}
@@ -31,7 +31,7 @@ describe('ComposeOperation', () => {
     vi.clearAllMocks();
 
     mockAIService = { compose: vi.fn() } as any;
-    mockStreamingService = { handleStreamingResponse: vi.fn() } as any;
+    mockStreamingService = { handleStreamingResponse: vi.fn().mockResolvedValue({ completed: true, content: '' }) } as any;
     mockConfigService = { getConfig: vi.fn() } as any;
     mockEditor = {
       lastLine: vi.fn().mockReturnValue(0),
@@ -80,7 +80,7 @@ describe('ComposeOperation', () => {
   it('should handle non-streaming response', async () => {
     const mockResponse = {
       headers: new Headers({ 'content-type': 'application/json' }),
-      json: vi.fn().mockResolvedValue({ result: 'Generated text' }),
+      json: vi.fn().mockResolvedValue({ text: 'Generated text' }),
     };
     (mockAIService.compose as any).mockResolvedValue(mockResponse);
 
@@ -113,6 +113,28 @@ describe('ComposeOperation', () => {
     expect(mockStreamingService.handleStreamingResponse).toHaveBeenCalled();
   });
 
+  it('should insert the composed text and a provenance footer with the response model', async () => {
+    const mockResponse = {
+      headers: new Headers({ 'content-type': 'application/json' }),
+      json: vi.fn().mockResolvedValue({ text: 'Generated text', model: 'served-model' }),
+    };
+    (mockAIService.compose as any).mockResolvedValue(mockResponse);
+    mockSettings.compose = { ...mockSettings.compose!, provenance: true };
+
+    await composeOperation.execute(mockEditor, 'test topic', mockSettings);
+
+    expect(mockEditor.replaceRange).toHaveBeenCalledWith(
+      '\n\n**New Idea**\n\nGenerated text',
+      expect.anything(),
+      expect.anything()
+    );
+    expect(mockEditor.replaceRange).toHaveBeenCalledWith(
+      expect.stringContaining('<!-- ai-backends: operation=compose model=served-model date='),
+      expect.anything(),
+      expect.anything()
+    );
+  });
+
   it('should handle API errors gracefully', async () => {
     const consoleErrorSpy = vi.spyOn(console, 'error').mockImplementation(() => {});
     (mockAIService.compose as any).mockRejectedValue(new Error('API Error'));
@@ -126,7 +148,7 @@ describe('ComposeOperation', () => {
   it('should construct the correct request body', async () => {
     const mockResponse = {
       headers: new Headers({ 'content-type': 'application/json' }),
-      json: vi.fn().mockResolvedValue({ result: 'Generated text' }),
+      json: vi.fn().mockResolvedValue({ text: 'Generated text' }),
     };
     (mockAIService.compose as any).mockResolvedValue(mockResponse);
 
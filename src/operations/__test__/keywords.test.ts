@@ -16,6 +16,8 @@ vi.mock('obsidian', () => ({
   Notice: vi.fn(),
 }));
 
+const sampleText = 'This is a test text for keyword extraction.';
+
 describe('KeywordsOperation', () => {
   let keywordsOperation: KeywordsOperation;
   let mockAIService: AIService;
@@ -70,24 +72,58 @@ describe('KeywordsOperation', () => {
     expect(Notice).toHaveBeenCalledWith('Please configure the keywords settings in the plugin settings first');
   });
 
+  it('should skip the API call for a selection that is only a title', async () => {
+    await keywordsOperation.execute(mockEditor, 'Quarterly planning meeting notes', mockSettings);
+
+    expect(mockAIService.extractKeywords).not.toHaveBeenCalled();
+    expect(Notice).toHaveBeenCalledWith('Selection is too short to extract keywords from');
+  });
+
+  it('should not skip CJK text', async () => {
+    const cjkText = '機械学習は、データから規則性を学習する技術である。近年、深層学習の発展により画像認識や自然言語処理の精度が大きく向上した。一方で、学習には大量のデータと計算資源が必要になる。';
+    (mockAIService.extractKeywords as any).mockResolvedValue({
+      json: vi.fn().mockResolvedValue({ keywords: ['keyword1'] }),
+    });
+
+    await keywordsOperation.execute(mockEditor, cjkText, mockSettings);
+
+    expect(mockAIService.extractKeywords).toHaveBeenCalled();
+    expect(Notice).not.toHaveBeenCalledWith('Selection is too short to extract keywords from');
+  });
+
   it('should extract and display keywords', async () => {
     const mockResponse = {
       json: vi.fn().mockResolvedValue({ keywords: ['keyword1', 'keyword2'] }),
     };
     (mockAIService.extractKeywords as any).mockResolvedValue(mockResponse);
 
-    await keywordsOperation.execute(mockEditor, 'test text', mockSettings);
+    await keywordsOperation.execute(mockEditor, sampleText, mockSettings);
 
     expect(mockAIService.extractKeywords).toHaveBeenCalled();
     expect(mockEditor.replaceRange).toHaveBeenCalledWith('\n\n**Keywords:**\n- keyword1\n- keyword2', { line: 0, ch: 0 });
     expect(Notice).toHaveBeenCalledWith('Keywords extracted successfully');
   });
 
+  it('should record the response model in the keywords provenance footer', async () => {
+    const mockResponse = {
+      json: vi.fn().mockResolvedValue({ keywords: ['keyword1'], model: 'served-model' }),
+    };
+    (mockAIService.extractKeywords as any).mockResolvedValue(mockResponse);
+    mockSettings.keywords = { ...mockSettings.keywords!, provenance: true };
+
+    await keywordsOperation.execute(mockEditor, sampleText, mockSettings);
+
+    expect(mockEditor.replaceRange).toHaveBeenCalledWith(
+      expect.stringContaining('<!-- ai-backends: operation=keywords model=served-model date='),
+      { line: 0, ch: 0 }
+    );
+  });
+
   it('should handle API errors gracefully', async () => {
     const consoleErrorSpy = vi.spyOn(console, 'error').mockImplementation(() => {});
     (mockAIService.extractKeywords as any).mockRejectedValue(new Error('API Error'));
 
-    await keywordsOperation.execute(mockEditor, 'test text', mockSettings);
+    await keywordsOperation.execute(mockEditor, sampleText, mockSettings);
 
     expect(Notice).toHaveBeenCalledWith('Please configure the keywords settings in the plugin settings first');
     consoleErrorSpy.mockRestore();
@@ -99,12 +135,11 @@ describe('KeywordsOperation', () => {
     };
     (mockAIService.extractKeywords as any).mockResolvedValue(mockResponse);
 
-    const text = 'This is a test text for keyword extraction.';
-    await keywordsOperation.execute(mockEditor, text, mockSettings);
+    await keywordsOperation.execute(mockEditor, sampleText, mockSettings);
 
     const expectedBody: KeywordsRequest = {
       payload: {
-        text: text,
+        text: sampleText,
         maxKeywords: 5,
       },
       config: {
@@ -30,7 +30,7 @@ describe('RewriteOperation', () => {
     vi.clearAllMocks();
 
     mockAIService = { rewrite: vi.fn() } as any;
-    mockStreamingService = { handleStreamingResponse: vi.fn() } as any;
+    mockStreamingService = { handleStreamingResponse: vi.fn().mockResolvedValue({ completed: true, content: '' }) } as any;
     mockConfigService = { getConfig: vi.fn() } as any;
     mockEditor = {
       lastLine: vi.fn().mockReturnValue(0),
@@ -110,6 +110,23 @@ describe('RewriteOperation', () => {
     expect(mockStreamingService.handleStreamingResponse).toHaveBeenCalled();
   });
 
+  it('should append provenance footer with the response model when enabled', async () => {
+    const mockResponse = {
+      headers: new Headers({ 'content-type': 'application/json' }),
+      json: vi.fn().mockResolvedValue({ text: 'Rewritten text', model: 'served-model' }),
+    };
+    (mockAIService.rewrite as any).mockResolvedValue(mockResponse);
+    mockSettings.rewrite = { ...mockSettings.rewrite!, provenance: true };
+
+    await rewriteOperation.execute(mockEditor, 'text', 'instruction', 'tone', 'header', mockSettings);
+
+    expect(mockEditor.replaceRange).toHaveBeenCalledWith(
+      expect.stringContaining('<!-- ai-backends: operation=rewrite model=served-model date='),
+      expect.anything(),
+      expect.anything()
+    );
+  });
+
   it('should handle API errors gracefully', async () => {
     const consoleErrorSpy = vi.spyOn(console, 'error').mockImplementation(() => {});
     (mockAIService.rewrite as any).mockRejectedValue(new Error('API Error'));
@@ -18,6 +18,8 @@ vi.mock('obsidian', () => ({
   Notice: vi.fn(),
 }));
 
+const sampleText = 'This is a long text to be summarized. It has several sentences. Each one adds some detail.';
+
 describe('SummarizeOperation', () => {
   let summarizeOperation: SummarizeOperation;
   let mockAIService: AIService;
@@ -30,7 +32,7 @@ describe('SummarizeOperation', () => {
     vi.clearAllMocks();
 
     mockAIService = { summarize: vi.fn() } as any;
-    mockStreamingService = { handleStreamingResponse: vi.fn() } as any;
+    mockStreamingService = { handleStreamingResponse: vi.fn().mockResolvedValue({ completed: true, content: '' }) } as any;
     mockConfigService = { getConfig: vi.fn() } as any;
     mockEditor = {
       lastLine: vi.fn().mockReturnValue(0),
@@ -93,6 +95,26 @@ describe('SummarizeOperation', () => {
     expect(Notice).toHaveBeenCalledWith('Please configure the summarize settings in the plugin settings first');
   });
 
+  it('should skip the API call for text that is too short to summarize', async () => {
+    await summarizeOperation.execute(mockEditor, 'First sentence. Second sentence.', mockSettings);
+
+    expect(mockAIService.summarize).not.toHaveBeenCalled();
+    expect(Notice).toHaveBeenCalledWith('Selection is too short to summarize');
+  });
+
+  it('should not skip a long bullet list without periods', async () => {
+    const bulletList = Array.from({ length: 40 }, (_, i) => `- Task item number ${i + 1}`).join('\n');
+    (mockAIService.summarize as any).mockResolvedValue({
+      headers: new Headers({ 'content-type': 'application/json' }),
+      json: vi.fn().mockResolvedValue({ summary: 'This is a summary.' }),
+    });
+
+    await summarizeOperation.execute(mockEditor, bulletList, mockSettings);
+
+    expect(mockAIService.summarize).toHaveBeenCalled();
+    expect(Notice).not.toHaveBeenCalledWith('Selection is too short to summarize');
+  });
+
   it('should handle non-streaming response', async () => {
     const mockResponse = {
       headers: new Headers({ 'content-type': 'application/json' }),
@@ -100,7 +122,7 @@ describe('SummarizeOperation', () => {
     };
     (mockAIService.summarize as any).mockResolvedValue(mockResponse);
 
-    await summarizeOperation.execute(mockEditor, 'test text', mockSettings);
+    await summarizeOperation.execute(mockEditor, sampleText, mockSettings);
 
     expect(mockAIService.summarize).toHaveBeenCalled();
     expect(Notice).toHaveBeenCalledWith('Text summarized successfully');
@@ -125,17 +147,39 @@ describe('SummarizeOperation', () => {
       };
 
 
-      await summarizeOperation.execute(mockEditor, 'test text', mockSettings);
+      await summarizeOperation.execute(mockEditor, sampleText, mockSettings);
 
     expect(mockAIService.summarize).toHaveBeenCalled();
     expect(mockStreamingService.handleStreamingResponse).toHaveBeenCalled();
   });
 
+  it('should mark the provenance footer incomplete when the stream fails part-way', async () => {
+    const mockResponse = {
+      headers: new Headers({ 'content-type': 'text/event-stream' }),
+      body: new ReadableStream(),
+    };
+    (mockAIService.summarize as any).mockResolvedValue(mockResponse);
+    (mockStreamingService.handleStreamingResponse as any).mockResolvedValue({
+      completed: false,
+      content: 'Partial summ',
+      model: 'gemma3:270m',
+    });
+    mockSettings.summarize = { ...mockSettings.summarize!, stream: true, provenance: true };
+
+    await summarizeOperation.execute(mockEditor, sampleText, mockSettings);
+
+    expect(mockEditor.replaceRange).toHaveBeenCalledWith(
+      expect.stringMatching(/operation=summarize model=gemma3:270m date=\S+ status=incomplete -->$/),
+      expect.anything(),
+      expect.anything()
+    );
+  });
+
   it('should handle API errors gracefully', async () => {
     const consoleErrorSpy = vi.spyOn(console, 'error').mockImplementation(() => {});
     (mockAIService.summarize as any).mockRejectedValue(new Error('API Error'));
 
-    await summarizeOperation.execute(mockEditor, 'test text', mockSettings);
+    await summarizeOperation.execute(mockEditor, sampleText, mockSettings);
 
     expect(Notice).toHaveBeenCalledWith('Please configure the summarize settings in the plugin settings first');
     consoleErrorSpy.mockRestore();
@@ -148,12 +192,11 @@ describe('SummarizeOperation', () => {
     };
     (mockAIService.summarize as any).mockResolvedValue(mockResponse);
 
-    const text = 'This is a long text to be summarized.';
-    await summarizeOperation.execute(mockEditor, text, mockSettings);
+    await summarizeOperation.execute(mockEditor, sampleText, mockSettings);
 
     const expectedBody: SummarizeRequest = {
       payload: {
-        text: text,
+        text: sampleText,
         maxLength: 150,
       },
       config: {
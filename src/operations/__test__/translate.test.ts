@@ -30,7 +30,7 @@ describe('TranslateOperation', () => {
     vi.clearAllMocks();
 
     mockAIService = { translate: vi.fn() } as any;
-    mockStreamingService = { handleStreamingResponse: vi.fn() } as any;
+    mockStreamingService = { handleStreamingResponse: vi.fn().mockResolvedValue({ completed: true, content: '' }) } as any;
     mockConfigService = { getConfig: vi.fn() } as any;
     mockEditor = {
       lastLine: vi.fn().mockReturnValue(0),
@@ -122,6 +122,23 @@ describe('TranslateOperation', () => {
     expect(mockStreamingService.handleStreamingResponse).toHaveBeenCalled();
   });
 
+  it('should append provenance footer with the response model when enabled', async () => {
+    const mockResponse = {
+      headers: new Headers({ 'content-type': 'application/json' }),
+      json: vi.fn().mockResolvedValue({ translation: 'Translated text', model: 'served-model' }),
+    };
+    (mockAIService.translate as any).mockResolvedValue(mockResponse);
+    mockSettings.translate = { ...mockSettings.translate!, provenance: true };
+
+    await translateOperation.execute(mockEditor, 'test text', mockSettings);
+
+    expect(mockEditor.replaceRange).toHaveBeenCalledWith(
+      expect.stringContaining('<!-- ai-backends: operation=translate model=served-model date='),
+      expect.anything(),
+      expect.anything()
+    );
+  });
+
   it('should handle API errors gracefully', async () => {
     const consoleErrorSpy = vi.spyOn(console, 'error').mockImplementation(() => {});
     (mockAIService.translate as any).mockRejectedValue(new Error('API Error'));
@@ -4,8 +4,9 @@ import {AIService} from "../services/ai-service";
 import {StreamingService} from "../services/streaming-service";
 import {ConfigService} from "../services/config-service";
 import {ComposeRequest} from "../types/requests";
-import {TranslateResponse} from "../types/responses";
+import {ComposeResponse} from "../types/responses";
 import {appendToEndOfDocument} from "../utils/editor-utils";
+import {appendProvenanceFooter} from "../utils/provenance-utils";
 
 export class ComposeOperation {
     private aiService: AIService;
@@ -52,19 +53,32 @@ export class ComposeOperation {
             const isStreaming = settings.compose.stream &&
                 (contentType.includes('text/event-stream') || contentType.includes('application/x-ndjson') || response.body);
 
+            let output = '';
+            let model: string | undefined;
+            let completed = true;
+
             if (isStreaming && response.body) {
-                await this.streamingService.handleStreamingResponse(
+                const streamResult = await this.streamingService.handleStreamingResponse(
                     response,
                     editor,
                     `\n\n**New Idea**\n\n`,
                     'Composed successfully'
                 );
+                output = streamResult.content;
+                completed = streamResult.completed;
+                model = streamResult.model;
             } else {
                 // Handle non-streaming response
-                const result: TranslateResponse = await response.json();
-                appendToEndOfDocument(editor, `\n\n**New Idea**\n\n`,);
+                const result: ComposeResponse = await response.json();
+                output = result.text || '';
+                model = result.model;
+                appendToEndOfDocument(editor, `\n\n**New Idea**\n\n${output}`);
                 new Notice('Composed successfully');
             }
+
+            if (settings.compose.provenance) {
+                appendProvenanceFooter(editor, 'compose', model || settings.compose.model, output, completed);
+            }
         } catch (error) {
             console.error('Compose operation error:', error);
             new Notice('Please configure the compose settings in the plugin settings first');
@@ -3,6 +3,8 @@ import { AIService } from '../services/ai-service';
 import { AIPluginSettings } from '../types/config';
 import { KeywordsRequest } from '../types/requests';
 import { KeywordsResponse } from '../types/responses';
+import { buildProvenanceFooter } from '../utils/provenance-utils';
+import { isTrivialForKeywords } from '../utils/text-utils';
 
 export class KeywordsOperation {
 	private aiService: AIService;
@@ -22,6 +24,11 @@ export class KeywordsOperation {
 			return;
 		}
 
+		if (isTrivialForKeywords(text)) {
+			new Notice('Selection is too short to extract keywords from');
+			return;
+		}
+
 		try {
 			const requestBody: KeywordsRequest = {
 				payload: {
@@ -43,7 +50,10 @@ export class KeywordsOperation {
 			const cursor = editor.getCursor('to');
 			editor.setCursor(cursor);
 			const keywordsList = result.keywords.map(keyword => `- ${keyword}`).join('\n');
-			editor.replaceRange(`\n\n**Keywords:**\n${keywordsList}`, cursor);
+			const footer = settings.keywords.provenance && result.keywords.length > 0
+				? `\n\n${buildProvenanceFooter('keywords', result.model || settings.keywords.model)}`
+				: '';
+			editor.replaceRange(`\n\n**Keywords:**\n${keywordsList}${footer}`, cursor);
 
 			new Notice('Keywords extracted successfully');
 		} catch (error) {
@@ -6,6 +6,7 @@ import { AIPluginSettings } from '../types/config';
 import { RewriteRequest } from '../types/requests';
 import { RewriteResponse } from '../types/responses';
 import { appendToEndOfDocument } from '../utils/editor-utils';
+import { appendProvenanceFooter } from '../utils/provenance-utils';
 
 export class RewriteOperation {
 	private aiService: AIService;
@@ -47,20 +48,32 @@ export class RewriteOperation {
 			const isStreaming = settings.rewrite.stream &&
 				(contentType.includes('text/event-stream') || contentType.includes('application/x-ndjson') || response.body);
 
+			let output = '';
+			let model: string | undefined;
+			let completed = true;
+
 			if (isStreaming && response.body) {
-				await this.streamingService.handleStreamingResponse(
+				const streamResult = await this.streamingService.handleStreamingResponse(
 					response,
 					editor,
 					`\n\n**${headerLabel}:**\n\n`,
 					'Action applied successfully'
 				);
+				output = streamResult.content;
+				completed = streamResult.completed;
+				model = streamResult.model;
 			} else {
 				const result: RewriteResponse = await response.json();
-				const output = result.text || result.result || result.output || result.content || result.message || '';
+				output = result.text || result.result || result.output || result.content || result.message || '';
+				model = result.model;
 
 				appendToEndOfDocument(editor, `\n\n**${headerLabel}:**\n\n${output}`);
 				new Notice('Action applied successfully');
 			}
+
+			if (settings.rewrite.provenance) {
+				appendProvenanceFooter(editor, 'rewrite', model || settings.rewrite.model, output, completed);
+			}
 		} catch (error) {
 			console.error('Error applying rewrite:', error);
 			new Notice('Please configure the rewrite settings in the plugin settings first');
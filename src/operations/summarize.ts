@@ -6,6 +6,8 @@ import { AIPluginSettings } from '../types/config';
 import { SummarizeRequest } from '../types/requests';
 import { SummarizeResponse } from '../types/responses';
 import { appendToEndOfDocument } from '../utils/editor-utils';
+import { appendProvenanceFooter } from '../utils/provenance-utils';
+import { isTrivialForSummary } from '../utils/text-utils';
 
 export class SummarizeOperation {
 	private aiService: AIService;
@@ -27,6 +29,11 @@ export class SummarizeOperation {
 			return;
 		}
 
+		if (isTrivialForSummary(text)) {
+			new Notice('Selection is too short to summarize');
+			return;
+		}
+
 		try {
 			const requestBody: SummarizeRequest = {
 				payload: {
@@ -48,18 +55,31 @@ export class SummarizeOperation {
 			const isStreaming = settings.summarize.stream &&
 				(contentType.includes('text/event-stream') || contentType.includes('application/x-ndjson') || response.body);
 
+			let output = '';
+			let model: string | undefined;
+			let completed = true;
+
 			if (isStreaming && response.body) {
-				await this.streamingService.handleStreamingResponse(
+				const streamResult = await this.streamingService.handleStreamingResponse(
 					response,
 					editor,
 					'\n\n**Summary:**\n\n',
 					'Text summarized successfully'
 				);
+				output = streamResult.content;
+				completed = streamResult.completed;
+				model = streamResult.model;
 			} else {
 				// Handle non-streaming response
 				const result: SummarizeResponse = await response.json();
 				appendToEndOfDocument(editor, `\n\n**Summary:**\n\n ${result.summary}`);
 				new Notice('Text summarized successfully');
+				output = result.summary || '';
+				model = result.model;
+			}
+
+			if (settings.summarize.provenance) {
+				appendProvenanceFooter(editor, 'summarize', model || settings.summarize.model, output, completed);
 			}
 		} catch (error) {
 			console.error('Error summarizing text:', error);
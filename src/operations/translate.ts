@@ -6,6 +6,7 @@ import { AIPluginSettings } from '../types/config';
 import { TranslateRequest } from '../types/requests';
 import { TranslateResponse } from '../types/responses';
 import { appendToEndOfDocument } from '../utils/editor-utils';
+import { appendProvenanceFooter } from '../utils/provenance-utils';
 
 export class TranslateOperation {
 	private aiService: AIService;
@@ -56,18 +57,31 @@ export class TranslateOperation {
 			const isStreaming = settings.translate.stream &&
 				(contentType.includes('text/event-stream') || contentType.includes('application/x-ndjson') || response.body);
 
+			let output = '';
+			let model: string | undefined;
+			let completed = true;
+
 			if (isStreaming && response.body) {
-				await this.streamingService.handleStreamingResponse(
+				const streamResult = await this.streamingService.handleStreamingResponse(
 					response,
 					editor,
 					`\n\n**Translation (${targetLanguage}):**\n\n`,
 					'Text translated successfully'
 				);
+				output = streamResult.content;
+				completed = streamResult.completed;
+				model = streamResult.model;
 			} else {
 				// Handle non-streaming response
 				const result: TranslateResponse = await response.json();
 				appendToEndOfDocument(editor, `\n\n**Translation (${targetLanguage}):**\n\n${result.translation}`);
 				new Notice('Text translated successfully');
+				output = result.translation || '';
+				model = result.model;
+			}
+
+			if (settings.translate.provenance) {
+				appendProvenanceFooter(editor, 'translate', model || settings.translate.model, output, completed);
 			}
 		} catch (error) {
 			console.error('Error translating text:', error);
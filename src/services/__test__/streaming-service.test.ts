@@ -299,6 +299,68 @@ describe('StreamingService', () => {
             expect(mockReader.releaseLock).toHaveBeenCalled();
         });
 
+        it('should report the streamed content and model on completion', async () => {
+            const mockReader = {
+                read: vi.fn()
+                    .mockResolvedValueOnce({
+                        done: false,
+                        value: new TextEncoder().encode('data: {"content": "Hello ", "model": "gemma3:270m"}\n')
+                    })
+                    .mockResolvedValueOnce({
+                        done: false,
+                        value: new TextEncoder().encode('data: {"content": "World!", "done": true}\n')
+                    })
+                    .mockResolvedValueOnce({
+                        done: true,
+                        value: undefined
+                    }),
+                releaseLock: vi.fn()
+            };
+
+            const mockResponse = {
+                body: {
+                    getReader: vi.fn().mockReturnValue(mockReader)
+                }
+            } as any;
+
+            const result = await streamingService.handleStreamingResponse(
+                mockResponse,
+                mockEditor,
+                '\n\n**Test:**\n\n',
+                'Completed'
+            );
+
+            expect(result).toEqual({ completed: true, content: 'Hello World!', model: 'gemma3:270m' });
+        });
+
+        it('should report an incomplete stream when reading fails', async () => {
+            const mockReader = {
+                read: vi.fn()
+                    .mockResolvedValueOnce({
+                        done: false,
+                        value: new TextEncoder().encode('data: {"content": "Partial"}\n')
+                    })
+                    .mockRejectedValueOnce(new Error('Stream error')),
+                releaseLock: vi.fn()
+            };
+
+            const mockResponse = {
+                body: {
+                    getReader: vi.fn().mockReturnValue(mockReader)
+                }
+            } as any;
+
+            const result = await streamingService.handleStreamingResponse(
+                mockResponse,
+                mockEditor,
+                '\n\n**Test:**\n\n',
+                'Completed'
+            );
+
+            expect(result.completed).toBe(false);
+            expect(result.content).toBe('Partial');
+        });
+
         it('should process remaining buffer content', async () => {
             const mockReader = {
                 read: vi.fn()
@@ -1,5 +1,5 @@
 import { Editor, Notice } from 'obsidian';
-import { StreamChunk } from '../types/responses';
+import { StreamChunk, StreamResult } from '../types/responses';
 import { appendToEndOfDocument } from '../utils/editor-utils';
 
 export class StreamingService {
@@ -8,7 +8,7 @@ export class StreamingService {
 		editor: Editor, 
 		headerText: string, 
 		successMessage: string
-	): Promise<void> {
+	): Promise<StreamResult> {
 		const reader = response.body!.getReader();
 		const decoder = new TextDecoder();
 
@@ -17,6 +17,8 @@ export class StreamingService {
 
 		let buffer = '';
 		let totalContent = '';
+		let model: string | undefined;
+		let completed = true;
 
 		try {
 			while (true) {
@@ -58,6 +60,9 @@ export class StreamingService {
 						}
 
 						const streamData: StreamChunk = JSON.parse(jsonStr);
+						if (streamData.model) {
+							model = streamData.model;
+						}
 
 						// Try different possible field names for content
 						const content = streamData.content || streamData.text || streamData.delta || streamData.chunk || streamData.message;
@@ -84,7 +89,7 @@ export class StreamingService {
 
 						if (streamData.done) {
 							new Notice(successMessage);
-							return;
+							return { completed, content: totalContent, model };
 						}
 					} catch (parseError) {
 						// Skip malformed JSON chunks
@@ -101,6 +106,9 @@ export class StreamingService {
 					}
 					if (jsonStr !== '[DONE]' && jsonStr.startsWith('{')) {
 						const streamData: StreamChunk = JSON.parse(jsonStr);
+						if (streamData.model) {
+							model = streamData.model;
+						}
 						const content = streamData.content || streamData.text || streamData.delta || streamData.chunk || streamData.message;
 						if (content) {
 							totalContent += content;
@@ -115,11 +123,13 @@ export class StreamingService {
 				}
 			}
 		} catch (streamError) {
+			completed = false;
 			new Notice('Error during streaming: ' + streamError.message);
 		} finally {
 			reader.releaseLock();
 		}
 
 		new Notice(successMessage);
+		return { completed, content: totalContent, model };
 	}
 }
@@ -16,6 +16,7 @@ export interface AIPluginSettings {
 		model: string;
 		temperature: number;
 		stream: boolean;
+		provenance?: boolean;
 		maxLength: number;
 	};
 	
@@ -24,6 +25,7 @@ export interface AIPluginSettings {
 		model: string;
 		temperature: number;
 		stream: boolean;
+		provenance?: boolean;
 		maxKeywords: number;
 	};
 	
@@ -32,6 +34,7 @@ export interface AIPluginSettings {
 		model: string;
 		temperature: number;
 		stream: boolean;
+		provenance?: boolean;
 		defaultTargetLanguage: string;
 	};
 	
@@ -40,6 +43,7 @@ export interface AIPluginSettings {
 		model: string;
 		temperature: number;
 		stream: boolean;
+		provenance?: boolean;
 	};
 	
 	compose?: {
@@ -47,6 +51,7 @@ export interface AIPluginSettings {
 		model: string;
 		temperature: number;
 		stream: boolean;
+		provenance?: boolean;
 		maxLength: number;
 	};
 }
@@ -68,6 +73,7 @@ export const DEFAULT_SETTINGS: AIPluginSettings = {
 		model: 'gemma3:4b',
 		temperature: 0.3,
 		stream: true,
+		provenance: false,
 		maxLength: 100
 	},
 	
@@ -76,6 +82,7 @@ export const DEFAULT_SETTINGS: AIPluginSettings = {
 		model: 'mistrallite:latest',
 		temperature: 0.3,
 		stream: false,
+		provenance: false,
 		maxKeywords: 500
 	},
 	
@@ -84,6 +91,7 @@ export const DEFAULT_SETTINGS: AIPluginSettings = {
 		model: 'gemma3:4b',
 		temperature: 0.1,
 		stream: true,
+		provenance: false,
 		defaultTargetLanguage: 'en'
 	},
 	
@@ -91,7 +99,8 @@ export const DEFAULT_SETTINGS: AIPluginSettings = {
 		provider: 'ollama',
 		model: 'gemma3:4b',
 		temperature: 0.3,
-		stream: true
+		stream: true,
+		provenance: false
 	},
 	
 	compose: {
@@ -99,6 +108,7 @@ export const DEFAULT_SETTINGS: AIPluginSettings = {
 		model: 'gemma3:4b',
 		temperature: 0.3,
 		stream: true,
+		provenance: false,
 		maxLength: 50
 	}
 }
@@ -108,6 +118,7 @@ export interface AIOperationConfig {
 	model: string;
 	temperature: number;
 	stream: boolean;
+	provenance?: boolean;
 }
 
 export interface AIConfig {
@@ -13,6 +13,7 @@ export interface SummarizeResponse {
 export interface KeywordsResponse {
 	keywords: string[];
 	provider: string;
+	model?: string;
 	usage: {
 		input_tokens: number;
 		output_tokens: number;
@@ -49,6 +50,13 @@ export interface StreamChunk {
 	};
 }
 
+// Outcome of a streamed response once the stream has been consumed
+export interface StreamResult {
+	completed: boolean;
+	content: string;
+	model?: string;
+}
+
 
 export interface RewriteResponse {
 	// Response payloads can vary; support several common keys
@@ -266,6 +266,17 @@ export class AIPluginSettingTab extends PluginSettingTab {
 					await onSave(config);
 				}));
 
+		// Provenance
+		new Setting(content)
+			.setName('Provenance Footer')
+			.setDesc('Insert an HTML comment with the operation, model and date after generated content')
+			.addToggle(toggle => toggle
+				.setValue(config.provenance || false)
+				.onChange(async (value) => {
+					config.provenance = value;
+					await onSave(config);
+				}));
+
 		// Operation-specific settings
 		if (name === 'Summarize' || name === 'Compose') {
 			new Setting(content)
@@ -0,0 +1,82 @@
+import { describe, it, expect, beforeEach, afterEach, vi } from 'vitest';
+import { Editor } from 'obsidian';
+import { appendProvenanceFooter, buildProvenanceFooter } from '../provenance-utils';
+
+describe('provenance-utils', () => {
+  let mockEditor: Editor;
+
+  beforeEach(() => {
+    vi.useFakeTimers();
+    mockEditor = {
+      lastLine: vi.fn().mockReturnValue(2),
+      getLine: vi.fn().mockReturnValue('Generated text'),
+      setCursor: vi.fn(),
+      replaceRange: vi.fn(),
+    } as any;
+  });
+
+  afterEach(() => {
+    vi.useRealTimers();
+  });
+
+  describe('buildProvenanceFooter', () => {
+    it('should record the operation, model and date', () => {
+      vi.setSystemTime(new Date(2024, 4, 7, 12, 0));
+
+      expect(buildProvenanceFooter('summarize', 'gemma3:270m'))
+        .toBe('<!-- ai-backends: operation=summarize model=gemma3:270m date=2024-05-07 -->');
+    });
+
+    it('should use the local calendar date near midnight', () => {
+      vi.setSystemTime(new Date(2024, 0, 1, 0, 30));
+      expect(buildProvenanceFooter('summarize', 'model')).toContain('date=2024-01-01 ');
+
+      vi.setSystemTime(new Date(2024, 11, 31, 23, 30));
+      expect(buildProvenanceFooter('summarize', 'model')).toContain('date=2024-12-31 ');
+    });
+
+    it('should escape comment terminators in values', () => {
+      const footer = buildProvenanceFooter('summarize', 'bad--> model');
+      const commentBody = footer.slice('<!--'.length, -'-->'.length);
+
+      expect(commentBody).not.toContain('--');
+      expect(commentBody).toContain('model=bad-‐> model');
+    });
+
+    it('should mark incomplete output', () => {
+      vi.setSystemTime(new Date(2024, 4, 7, 12, 0));
+
+      expect(buildProvenanceFooter('translate', 'model', false))
+        .toBe('<!-- ai-backends: operation=translate model=model date=2024-05-07 status=incomplete -->');
+    });
+  });
+
+  describe('appendProvenanceFooter', () => {
+    it('should append the footer at the end of the document', () => {
+      appendProvenanceFooter(mockEditor, 'rewrite', 'model', 'Generated text');
+
+      const end = { line: 2, ch: 'Generated text'.length };
+      expect(mockEditor.replaceRange).toHaveBeenCalledWith(
+        expect.stringMatching(/^\n\n<!-- ai-backends: operation=rewrite model=model date=\S+ -->$/),
+        end,
+        end
+      );
+    });
+
+    it('should not append a footer when no output was generated', () => {
+      appendProvenanceFooter(mockEditor, 'rewrite', 'model', '  \n');
+
+      expect(mockEditor.replaceRange).not.toHaveBeenCalled();
+    });
+
+    it('should append an incomplete footer after partial output', () => {
+      appendProvenanceFooter(mockEditor, 'compose', 'model', 'Partial', false);
+
+      expect(mockEditor.replaceRange).toHaveBeenCalledWith(
+        expect.stringContaining('status=incomplete -->'),
+        expect.anything(),
+        expect.anything()
+      );
+    });
+  });
+});
@@ -0,0 +1,88 @@
+import { describe, it, expect } from 'vitest';
+import { countSentences, countWords, isTrivialForKeywords, isTrivialForSummary } from '../text-utils';
+
+const bulletList = Array.from({ length: 40 }, (_, i) => `- Task item number ${i + 1}`).join('\n');
+const cjkText = '機械学習は、データから規則性を学習する技術である。近年、深層学習の発展により画像認識や自然言語処理の精度が大きく向上した。一方で、学習には大量のデータと計算資源が必要になる。';
+const longTwoSentenceParagraph = 'The quarterly planning meeting covered the roadmap for the next two releases, including the migration of the storage layer, the new onboarding flow and the deprecation of the legacy export format that several enterprise customers still rely on. We agreed to revisit the timeline in three weeks once the infrastructure team has finished its capacity review and the design team has validated the onboarding prototypes with users.';
+
+const words = (count: number) => Array(count).fill('a').join(' ');
+
+describe('text-utils', () => {
+  describe('countWords', () => {
+    it('should count whitespace-separated words', () => {
+      expect(countWords('  one two\nthree\tfour  ')).toBe(4);
+    });
+
+    it('should count CJK text without spaces as a single word', () => {
+      expect(countWords(cjkText)).toBe(1);
+    });
+  });
+
+  describe('countSentences', () => {
+    it('should split on sentence-ending punctuation', () => {
+      expect(countSentences('One. Two! Three?')).toBe(3);
+    });
+
+    it('should count each list item as a sentence', () => {
+      expect(countSentences(bulletList)).toBe(40);
+    });
+
+    it('should split on CJK full stops', () => {
+      expect(countSentences(cjkText)).toBe(3);
+    });
+
+    it('should not split on periods inside words', () => {
+      expect(countSentences('Version 1.2 uses example.com today.')).toBe(1);
+    });
+  });
+
+  describe('isTrivialForSummary', () => {
+    it('should treat exactly three sentences as enough', () => {
+      expect(isTrivialForSummary('One. Two.')).toBe(true);
+      expect(isTrivialForSummary('One. Two. Three.')).toBe(false);
+    });
+
+    it('should treat exactly forty words as enough', () => {
+      expect(isTrivialForSummary(`${words(39)}.`)).toBe(true);
+      expect(isTrivialForSummary(`${words(40)}.`)).toBe(false);
+    });
+
+    it('should treat exactly 120 characters as enough', () => {
+      expect(isTrivialForSummary('x'.repeat(119))).toBe(true);
+      expect(isTrivialForSummary('x'.repeat(120))).toBe(false);
+    });
+
+    it('should not treat long lists, CJK text or long paragraphs as trivial', () => {
+      expect(isTrivialForSummary(bulletList)).toBe(false);
+      expect(isTrivialForSummary(cjkText)).toBe(false);
+      expect(isTrivialForSummary(longTwoSentenceParagraph)).toBe(false);
+    });
+  });
+
+  describe('isTrivialForKeywords', () => {
+    it('should treat a lone title as trivial', () => {
+      expect(isTrivialForKeywords('Quarterly planning meeting notes')).toBe(true);
+      expect(isTrivialForKeywords('# Meeting with the design team')).toBe(true);
+      expect(isTrivialForKeywords('第三四半期計画会議の議事録')).toBe(true);
+    });
+
+    it('should treat a title of ten words or 80 characters as content', () => {
+      expect(isTrivialForKeywords(words(9))).toBe(true);
+      expect(isTrivialForKeywords(words(10))).toBe(false);
+      expect(isTrivialForKeywords('あ'.repeat(79))).toBe(true);
+      expect(isTrivialForKeywords('あ'.repeat(80))).toBe(false);
+    });
+
+    it('should treat exactly five words or 20 characters as enough for a sentence', () => {
+      expect(isTrivialForKeywords('Call Bob now.')).toBe(true);
+      expect(isTrivialForKeywords('Call Bob and Alice now.')).toBe(false);
+      expect(isTrivialForKeywords('Planning meeting ran.')).toBe(false);
+    });
+
+    it('should not treat long lists, CJK text or long paragraphs as trivial', () => {
+      expect(isTrivialForKeywords(bulletList)).toBe(false);
+      expect(isTrivialForKeywords(cjkText)).toBe(false);
+      expect(isTrivialForKeywords(longTwoSentenceParagraph)).toBe(false);
+    });
+  });
+});
@@ -0,0 +1,28 @@
+import { Editor } from 'obsidian';
+import { appendToEndOfDocument } from './editor-utils';
+
+// "--" ends an HTML comment early, so break it up with a Unicode hyphen
+function escapeCommentValue(value: string): string {
+	return value.replace(/--/g, '-‐');
+}
+
+// The note's own timeline is local, so the footer uses the local calendar date
+function formatLocalDate(date: Date): string {
+	const month = String(date.getMonth() + 1).padStart(2, '0');
+	const day = String(date.getDate()).padStart(2, '0');
+	return `${date.getFullYear()}-${month}-${day}`;
+}
+
+export function buildProvenanceFooter(operation: string, model: string, completed = true): string {
+	const status = completed ? '' : ' status=incomplete';
+	return `<!-- ai-backends: operation=${escapeCommentValue(operation)} model=${escapeCommentValue(model)} date=${formatLocalDate(new Date())}${status} -->`;
+}
+
+export function appendProvenanceFooter(editor: Editor, operation: string, model: string, output: string, completed = true): void {
+	// Only mark content that was actually generated; a stream that failed
+	// part-way still leaves generated text behind, so it is marked incomplete
+	if (!output.trim()) {
+		return;
+	}
+	appendToEndOfDocument(editor, `\n\n${buildProvenanceFooter(operation, model, completed)}`);
+}
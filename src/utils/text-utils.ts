@@ -0,0 +1,52 @@
+// Below these sizes the model output is no more useful than the input itself.
+// Each guard also checks a character count, so selections that are long but
+// look short by one measure (e.g. CJK text without spaces) are still processed.
+const MIN_SUMMARIZE_SENTENCES = 3;
+const MIN_SUMMARIZE_WORDS = 40;
+const MIN_SUMMARIZE_CHARACTERS = 120;
+const MIN_KEYWORDS_WORDS = 5;
+const MIN_KEYWORDS_CHARACTERS = 20;
+// A lone heading or unpunctuated line this short is a title, not content
+const MAX_TITLE_WORDS = 10;
+const MAX_TITLE_CHARACTERS = 80;
+
+export function countWords(text: string): number {
+	return text.split(/\s+/).filter(word => word.length > 0).length;
+}
+
+export function countSentences(text: string): number {
+	// Lines are counted separately so list items and headings without
+	// punctuation still count; CJK full stops need no trailing whitespace
+	return text
+		.split(/\r?\n/)
+		.map(line => line.split(/[.!?]+(?:\s+|$)|[。！？]+/).filter(sentence => sentence.trim().length > 0).length)
+		.reduce((total, count) => total + count, 0);
+}
+
+export function isTrivialForSummary(text: string): boolean {
+	return countSentences(text) < MIN_SUMMARIZE_SENTENCES &&
+		countWords(text) < MIN_SUMMARIZE_WORDS &&
+		text.trim().length < MIN_SUMMARIZE_CHARACTERS;
+}
+
+function isTitleOnly(text: string): boolean {
+	const lines = text.split(/\r?\n/).filter(line => line.trim().length > 0);
+	if (lines.length !== 1) {
+		return false;
+	}
+
+	const line = lines[0].trim();
+	const isHeading = /^#{1,6}\s/.test(line);
+	const hasSentenceEnd = /[.!?。！？]$/.test(line);
+	return (isHeading || !hasSentenceEnd) &&
+		countWords(line) < MAX_TITLE_WORDS &&
+		line.length < MAX_TITLE_CHARACTERS;
+}
+
+export function isTrivialForKeywords(text: string): boolean {
+	if (isTitleOnly(text)) {
+		return true;
+	}
+	return countWords(text) < MIN_KEYWORDS_WORDS &&
+		text.trim().length < MIN_KEYWORDS_CHARACTERS;
+}